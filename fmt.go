@@ -1,6 +1,8 @@
 package main
 
 import (
+	"bytes"
+	"flag"
 	"fmt"
 	"io/ioutil"
 	"monkey/formatter"
@@ -8,20 +10,73 @@ import (
 )
 
 func main() {
-	args := os.Args[1:]
+	opts := formatter.DefaultOptions
+	flag.IntVar(&opts.Indent, "indent", opts.Indent, "the number of spaces of an indentation level, 0 for tabs")
+	flag.IntVar(&opts.LineWidth, "width", opts.LineWidth, "the maximum line width, 0 for no wrapping")
+	check := flag.Bool("check", false, "report the files which are not formatted, and exit with status 1 if there are any")
+	write := flag.Bool("w", false, "write the result to the files instead of the standard output")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: fmt [options] [file ...]\n\nFormat the monkey files, or the standard input if no file is given.\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
 
-	var f []byte
-	var err error
-	if len(args) == 0 {
-		f, err = ioutil.ReadAll(os.Stdin)
-	} else {
-		f, err = ioutil.ReadFile(args[0])
+	if flag.NArg() == 0 {
+		src, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Formatter:", err)
+			os.Exit(2)
+		}
+		out, err := formatter.New("", string(src), opts).Format()
 		if err != nil {
-			fmt.Println("Formatter: cannot read file", err.Error())
-			os.Exit(1)
+			fmt.Fprintln(os.Stderr, "Formatter:", err)
+			os.Exit(2)
 		}
+		if *check {
+			if out != string(src) {
+				fmt.Println("<standard input>")
+				os.Exit(1)
+			}
+			return
+		}
+		os.Stdout.WriteString(out)
+		return
 	}
 
-	formatter := formatter.New(string(f))
-	formatter.Format()
+	status := 0
+	for _, filename := range flag.Args() {
+		src, err := ioutil.ReadFile(filename)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Formatter: cannot read file", err.Error())
+			status = 2
+			continue
+		}
+		out, err := formatter.New(filename, string(src), opts).Format()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Formatter:", err)
+			status = 2
+			continue
+		}
+
+		changed := !bytes.Equal(src, []byte(out))
+		switch {
+		case *check:
+			if changed {
+				fmt.Println(filename)
+				if status == 0 {
+					status = 1
+				}
+			}
+		case *write:
+			if changed {
+				if err := ioutil.WriteFile(filename, []byte(out), 0644); err != nil {
+					fmt.Fprintln(os.Stderr, "Formatter:", err)
+					status = 2
+				}
+			}
+		default:
+			os.Stdout.WriteString(out)
+		}
+	}
+	os.Exit(status)
 }
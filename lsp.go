@@ -0,0 +1,19 @@
+/* Language server for monkey, talks with the editor through stdin and stdout. */
+package main
+
+import (
+	"fmt"
+	"monkey/lsp"
+	"os"
+)
+
+func main() {
+	ok, err := lsp.NewServer(os.Stdin, os.Stdout).Run()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "lsp:", err)
+		os.Exit(1)
+	}
+	if !ok { //exited without a shutdown request
+		os.Exit(1)
+	}
+}
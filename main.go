@@ -3,20 +3,40 @@ package main
 import (
 	"fmt"
 	"bufio"
+	"database/sql"
 	"io/ioutil"
 	"log"
 	"time"
 	"regexp"
 	"runtime"
 	"math/rand"
+	"monkey/checker"
+	"monkey/coverage"
+	"monkey/debugger"
 	"monkey/eval"
 	"monkey/lexer"
 	"monkey/parser"
+	"monkey/profiler"
 	"monkey/repl"
+	"monkey/testrunner"
 	"os"
+	"strconv"
+	"strings"
 )
 
-func runProgram(filename string) {
+// runOptions are the options of running a script
+type runOptions struct {
+	debug        bool   //run the script under the debugger
+	profile      string //the pprof profile's file name, the script is not profiled if empty
+	profileTop   int    //the number of the functions and lines reported by the profiler
+	coverage     string //the lcov file's name
+	coverageHTML string //the directory of the annotated html pages
+}
+
+// runProgram runs the script. If 'opts.profile' is not empty, the script is profiled, the pprof profile
+// is written to the file, and the top 'opts.profileTop' functions and lines are reported to stderr.
+// If 'opts.coverage' or 'opts.coverageHTML' is not empty, the coverage of the script is reported.
+func runProgram(filename string, opts runOptions) {
 	wd, err := os.Getwd()
 	if err != nil {
 		fmt.Println(err.Error())
@@ -36,9 +56,24 @@ func runProgram(filename string) {
 		}
 		os.Exit(1)
 	}
+	for _, warning := range p.Warnings() {
+		fmt.Fprintln(os.Stderr, warning)
+	}
 	scope := eval.NewScope(nil)
 	RegisterGoGlobals()
-	eval.REPLColor = false
+	if opts.debug {
+		d := debugger.New(filename, wd, os.Stdout)
+		d.Start(eval.DefaultInterpreter()) //the interpreter of the program's scope
+		defer d.Stop()
+	}
+	if opts.profile != "" {
+		prof := profiler.New(profiler.DefaultInterval)
+		prof.Start(eval.DefaultInterpreter())
+		defer writeProfile(prof, opts.profile, opts.profileTop)
+	}
+	if opts.coverage != "" || opts.coverageHTML != "" {
+		defer startCoverage(opts.coverage, opts.coverageHTML, nil)()
+	}
 	eval.Eval(program, scope)
 //	e := eval.Eval(program, scope)
 //	if e.Inspect() != "nil" {
@@ -46,6 +81,230 @@ func runProgram(filename string) {
 //	}
 }
 
+func writeProfile(prof *profiler.Profiler, filename string, top int) {
+	prof.Stop()
+	f, err := os.Create(filename)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "monkey: ", err.Error())
+		os.Exit(1)
+	}
+	defer f.Close()
+	if err := prof.WritePprof(f); err != nil {
+		fmt.Fprintln(os.Stderr, "monkey: ", err.Error())
+		os.Exit(1)
+	}
+	prof.WriteReport(os.Stderr, top)
+}
+
+// startCoverage starts recording the coverage, the returned function writes the lcov file and the html pages,
+// and prints the summary to stderr. 'filter' selects the reported files, all the files if nil.
+func startCoverage(lcov string, htmlDir string, filter func(string) bool) func() {
+	cov := coverage.New()
+	cov.Filter = filter
+	cov.Start(eval.DefaultInterpreter())
+	return func() {
+		cov.Stop()
+		files := cov.Files()
+		var err error
+		if lcov != "" {
+			var out *os.File
+			if out, err = os.Create(lcov); err == nil {
+				err = coverage.WriteLcov(out, files)
+				out.Close()
+			}
+		}
+		if err == nil && htmlDir != "" {
+			err = coverage.WriteHTML(htmlDir, files)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "monkey: ", err.Error())
+			os.Exit(1)
+		}
+		lines, linesHit, branches, branchesHit := coverage.Summary(files)
+		fmt.Fprintf(os.Stderr, "coverage: %.1f%% of lines, %.1f%% of branches\n",
+			coverage.Percent(linesHit, lines), coverage.Percent(branchesHit, branches))
+	}
+}
+
+// checkPrograms type checks the files('monkey check file...'), the program is not run.
+func checkPrograms(filenames []string) {
+	wd, err := os.Getwd()
+	if err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
+
+	failed := false
+	for _, filename := range filenames {
+		f, err := ioutil.ReadFile(wd + "/" + filename)
+		if err != nil {
+			fmt.Println("monkey: ", err.Error())
+			os.Exit(1)
+		}
+		l := lexer.New(filename, string(f))
+		p := parser.New(l, wd)
+		program := p.ParseProgram()
+		if len(p.Errors()) != 0 {
+			for _, err := range p.Errors() {
+				fmt.Println(err)
+			}
+			failed = true
+			continue
+		}
+		for _, err := range checker.Check(program) {
+			fmt.Println(err)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// 'monkey test [-v] [--junit file] [dir|file...]': run the test functions of the '*_test.my' files
+func testPrograms(args []string) {
+	var verbose bool
+	var junit, lcov, htmlDir string
+	for len(args) > 0 && strings.HasPrefix(args[0], "-") {
+		switch args[0] {
+		case "-v":
+			verbose = true
+		case "--junit", "--coverage", "--coverage-html":
+			if len(args) < 2 {
+				fmt.Println("usage: monkey test [-v] [--junit file] [--coverage file] [--coverage-html dir] [dir|file...]")
+				os.Exit(1)
+			}
+			switch args[0] {
+			case "--junit":
+				junit = args[1]
+			case "--coverage":
+				lcov = args[1]
+			case "--coverage-html":
+				htmlDir = args[1]
+			}
+			args = args[1:]
+		default:
+			fmt.Printf("monkey test: unknown option '%s'\n", args[0])
+			os.Exit(1)
+		}
+		args = args[1:]
+	}
+	if len(args) == 0 {
+		args = []string{"."}
+	}
+
+	var files []string
+	for _, dir := range args {
+		found, err := testrunner.Discover(dir)
+		if err != nil {
+			fmt.Println("monkey: ", err.Error())
+			os.Exit(1)
+		}
+		files = append(files, found...)
+	}
+
+	RegisterGoGlobals()
+	var writeCoverage func()
+	if lcov != "" || htmlDir != "" { //the coverage of the code tested, not of the test files
+		writeCoverage = startCoverage(lcov, htmlDir, func(name string) bool { return !strings.HasSuffix(name, "_test.my") })
+	}
+	suites := testrunner.Run(files)
+	ok := testrunner.WriteReport(os.Stdout, suites, verbose)
+	if writeCoverage != nil {
+		writeCoverage()
+	}
+	if junit != "" {
+		out, err := os.Create(junit)
+		if err == nil {
+			err = testrunner.WriteJUnit(out, suites)
+			out.Close()
+		}
+		if err != nil {
+			fmt.Println("monkey: ", err.Error())
+			os.Exit(1)
+		}
+	}
+	if !ok {
+		os.Exit(1)
+	}
+}
+
+// 'monkey migrate [--dir dir] driver dsn up|down|status|redo [n]': apply or roll back the schema migrations
+// of the directory('migrations' by default), see the 'migrate' module
+func migrateDatabase(args []string) {
+	usage := "usage: monkey migrate [--dir dir] driver dsn up|down|status|redo [n]"
+	dir := "migrations"
+	if len(args) > 1 && args[0] == "--dir" {
+		dir = args[1]
+		args = args[2:]
+	}
+	if len(args) != 3 && len(args) != 4 {
+		fmt.Println(usage)
+		os.Exit(1)
+	}
+	driver, dsn, command := args[0], args[1], args[2]
+
+	n := 0 //all the pending migrations for 'up'
+	if command == "down" {
+		n = 1
+	}
+	if len(args) == 4 {
+		var err error
+		if n, err = strconv.Atoi(args[3]); err != nil {
+			fmt.Printf("monkey migrate: invalid number '%s'\n", args[3])
+			os.Exit(1)
+		}
+	}
+
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		fmt.Println("monkey: ", err.Error())
+		os.Exit(1)
+	}
+	defer db.Close()
+	s := &eval.SqlObject{Db: db, Name: driver + ":" + dsn}
+	RegisterGoGlobals() //for the '.my' migrations
+
+	var done []*eval.Migration
+	var verb string
+	switch command {
+	case "status":
+		var migrations []*eval.Migration
+		if migrations, err = eval.MigrationStatus(s, dir); err == nil {
+			for _, m := range migrations {
+				state := "pending"
+				if m.Applied {
+					state = "applied at " + m.AppliedAt
+				}
+				fmt.Printf("%-40s %s\n", m.ID(), state)
+			}
+		}
+	case "up":
+		done, err = eval.MigrateUp(s, dir, n, os.Stdout)
+		verb = "applied"
+	case "down":
+		done, err = eval.MigrateDown(s, dir, n, os.Stdout)
+		verb = "rolled back"
+	case "redo":
+		done, err = eval.MigrateRedo(s, dir, os.Stdout)
+		verb = "redone"
+		if err != nil { //rolled back, but not applied again
+			verb = "rolled back"
+		}
+	default:
+		fmt.Println(usage)
+		os.Exit(1)
+	}
+	for _, m := range done {
+		fmt.Println(verb, m.ID())
+	}
+	if err != nil {
+		fmt.Println("monkey: ", err.Error())
+		db.Close()
+		os.Exit(1)
+	}
+}
+
 // Register go package methods/types
 // Note here, we use 'gfmt', 'glog', 'gos' 'gtime', because in monkey
 // we already have built in module 'fmt', 'log' 'os', 'time'.
@@ -191,13 +450,62 @@ func RegisterGoGlobals() {
 }
 
 func main() {
-	args := os.Args[1:]
+	opts := runOptions{profileTop: 20}
+	args := append([]string{}, os.Args[1:]...)
+
+	//monkey options, must come before the script name
+	for len(args) > 0 && strings.HasPrefix(args[0], "--") {
+		switch args[0] {
+		case "--vm": //use the bytecode compiler & vm
+			eval.DefaultInterpreter().VM = true
+		case "--debug": //run the program under the debugger
+			opts.debug = true
+		case "--profile", "--profile-top", "--coverage", "--coverage-html": //the options with a value
+			if len(args) < 2 {
+				fmt.Printf("monkey: option '%s' needs a value\n", args[0])
+				os.Exit(1)
+			}
+			switch args[0] {
+			case "--profile": //profile the program, write the pprof profile to the file
+				opts.profile = args[1]
+			case "--profile-top":
+				n, err := strconv.Atoi(args[1])
+				if err != nil {
+					fmt.Printf("monkey: invalid value '%s' of option '%s'\n", args[1], args[0])
+					os.Exit(1)
+				}
+				opts.profileTop = n
+			case "--coverage": //record the coverage, write the lcov file
+				opts.coverage = args[1]
+			case "--coverage-html": //record the coverage, write the annotated html pages to the directory
+				opts.coverageHTML = args[1]
+			}
+			args = args[1:]
+			os.Args = append(os.Args[:1], os.Args[2:]...)
+		default:
+			fmt.Printf("monkey: unknown option '%s'\n", args[0])
+			os.Exit(1)
+		}
+		args = args[1:]
+		os.Args = append(os.Args[:1], os.Args[2:]...)
+	}
+
 	//We must reset `os.Args`, or the `flag` module will not functioning correctly
 	os.Args = os.Args[1:]
 	if len(args) == 0 {
 		fmt.Println("Monkey programming language REPL\n")
 		repl.Start(os.Stdout, true)
+	} else if args[0] == "check" {
+		if len(args) == 1 {
+			fmt.Println("usage: monkey check file...")
+			os.Exit(1)
+		}
+		checkPrograms(args[1:])
+	} else if args[0] == "test" {
+		testPrograms(args[1:])
+	} else if args[0] == "migrate" {
+		migrateDatabase(args[1:])
 	} else {
-		runProgram(args[0])
+		runProgram(args[0], opts)
 	}
 }
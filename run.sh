@@ -25,3 +25,7 @@ go build -o fmt fmt.go
 #     or  ./fmt demo.my | ./highlight   (generate: output.html)
 echo "Building Highlighter...(highlight)"
 go build -o highlight highlight.go
+
+# run: ./mlsp   (started by the editor, talks through stdin/stdout)
+echo "Building Language server...(mlsp)"
+go build -o mlsp lsp.go